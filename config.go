@@ -0,0 +1,70 @@
+package sqlasapi
+
+import "fmt"
+
+// Config bundles everything Sanitize needs in order to turn a raw `where` clause string into a
+// validated, ready-to-run SQL fragment: which Parser to use, and the whitelist of columns the
+// clause may refer to.
+type Config struct {
+	// Parser parses the raw where string into an Expr tree. If nil, Sanitize uses
+	// SqlparserParser.
+	Parser Parser
+
+	// Columns whitelists the column names a clause may refer to, along with each column's type
+	// and, for integer columns, its allowed range. Build this by hand, or via RegisterModel.
+	Columns map[string]ColumnSpec
+
+	// Dialect renders identifier quoting, placeholder syntax, and LIKE/ILIKE for the target
+	// database. Nil means GenericDialect: no identifier quoting and `?` placeholders.
+	Dialect Dialect
+
+	// AllowOr enables the OR operator. Off by default: OR clauses let a client cheaply widen a
+	// query far beyond what a column-only whitelist anticipates, so callers opt in explicitly.
+	AllowOr bool
+
+	// AllowLike enables the LIKE operator, subject to each column's ColumnSpec.Ops also
+	// allowing OpLike. Off by default for the same reason as AllowOr.
+	AllowLike bool
+
+	// CaseInsensitiveLike makes every LIKE in this where clause case-insensitive (e.g. Postgres
+	// ILIKE instead of LIKE), regardless of whether cfg.Parser ever sets Like.CaseInsensitive
+	// itself - SqlparserParser has no SQL syntax to express that per-clause, so this is the only
+	// way a Sanitize caller can ask for it.
+	CaseInsensitiveLike bool
+
+	// Limits, if set, caps the structural complexity Sanitize accepts - AST depth and node
+	// count, predicates per column, string literal length - before it renders the clause. Nil
+	// means no limits are enforced.
+	Limits *Limits
+}
+
+// Sanitize parses a raw, client-supplied `where` clause using cfg.Parser, validates it against
+// cfg.Columns, and returns a parameterized SQL fragment plus the args to go with it. It is the
+// entry point for callers that want to accept where clauses as plain strings instead of
+// hand-building an Expr tree.
+//
+// The returned sql never contains literal values - every value is replaced by a placeholder per
+// cfg.Dialect, and the literal itself is returned in args - so sql is safe to pass straight to
+// database/sql's db.Query(sql, args...), with no risk of the unescaped-quote injection that
+// inlining literals into the SQL text would allow.
+func Sanitize(where string, cfg Config) (sql string, args []any, err error) {
+	parser := cfg.Parser
+	if parser == nil {
+		parser = SqlparserParser{}
+	}
+	expr, err := parser.Parse(where)
+	if err != nil {
+		return "", nil, fmt.Errorf("parsing where clause: %w", err)
+	}
+	if cfg.Limits != nil {
+		if err := checkLimits(expr, *cfg.Limits); err != nil {
+			return "", nil, fmt.Errorf("where clause too complex: %w", err)
+		}
+	}
+	b := newParamBuilder(cfg.Dialect, cfg.AllowOr, cfg.AllowLike, cfg.CaseInsensitiveLike)
+	sql, err = processSqlExprParams(expr, cfg.Columns, b)
+	if err != nil {
+		return "", nil, fmt.Errorf("validating where clause: %w", err)
+	}
+	return sql, b.args, nil
+}
@@ -0,0 +1,67 @@
+package sqlasapi
+
+import "fmt"
+
+// Dialect renders the SQL-syntax differences between database backends that processSqlExprParams
+// would otherwise have to special-case inline: identifier quoting, placeholder syntax, and which
+// keyword performs a case-insensitive LIKE match. This is the same separation
+// Masterminds/squirrel draws between query building and dialect, and lets a where clause built
+// for one backend be rendered correctly against another just by swapping the Dialect.
+type Dialect interface {
+	// QuoteIdent quotes name for safe use as a column identifier.
+	QuoteIdent(name string) string
+	// Placeholder renders the bind-variable placeholder for the n-th value (1-based).
+	Placeholder(n int) string
+	// Like returns the keyword for a LIKE-style match. caseInsensitive asks for a
+	// case-insensitive match where the dialect distinguishes one; dialects whose LIKE is
+	// already case-insensitive (or collation-dependent) ignore the argument.
+	Like(caseInsensitive bool) string
+}
+
+// GenericDialect is the Dialect Sanitize falls back to when Config.Dialect is nil: no identifier
+// quoting (as in processSqlExpr) and `?` placeholders.
+type GenericDialect struct{}
+
+func (GenericDialect) QuoteIdent(name string) string { return name }
+func (GenericDialect) Placeholder(int) string         { return "?" }
+func (GenericDialect) Like(bool) string               { return "LIKE" }
+
+// PostgresDialect renders SQL for Postgres: double-quoted identifiers, `$1`-style placeholders,
+// and ILIKE for case-insensitive matches.
+type PostgresDialect struct{}
+
+func (PostgresDialect) QuoteIdent(name string) string { return fmt.Sprintf(`"%s"`, name) }
+func (PostgresDialect) Placeholder(n int) string       { return fmt.Sprintf("$%d", n) }
+func (PostgresDialect) Like(caseInsensitive bool) string {
+	if caseInsensitive {
+		return "ILIKE"
+	}
+	return "LIKE"
+}
+
+// MySQLDialect renders SQL for MySQL: backtick-quoted identifiers and `?` placeholders. MySQL
+// has no ILIKE keyword; LIKE's case sensitivity depends on the column's collation, so
+// caseInsensitive is ignored.
+type MySQLDialect struct{}
+
+func (MySQLDialect) QuoteIdent(name string) string { return fmt.Sprintf("`%s`", name) }
+func (MySQLDialect) Placeholder(int) string         { return "?" }
+func (MySQLDialect) Like(bool) string               { return "LIKE" }
+
+// SQLiteDialect renders SQL for SQLite: double-quoted identifiers and `?` placeholders. Like
+// MySQL, SQLite has no ILIKE keyword; its LIKE is already case-insensitive for ASCII, so
+// caseInsensitive is ignored.
+type SQLiteDialect struct{}
+
+func (SQLiteDialect) QuoteIdent(name string) string { return fmt.Sprintf(`"%s"`, name) }
+func (SQLiteDialect) Placeholder(int) string         { return "?" }
+func (SQLiteDialect) Like(bool) string               { return "LIKE" }
+
+// OracleDialect renders SQL for Oracle: double-quoted identifiers and `:1`-style numbered bind
+// variables. Oracle has no ILIKE keyword; case-insensitive matching depends on the column's
+// collation, so caseInsensitive is ignored.
+type OracleDialect struct{}
+
+func (OracleDialect) QuoteIdent(name string) string { return fmt.Sprintf(`"%s"`, name) }
+func (OracleDialect) Placeholder(n int) string       { return fmt.Sprintf(":%d", n) }
+func (OracleDialect) Like(bool) string               { return "LIKE" }
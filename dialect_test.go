@@ -0,0 +1,72 @@
+package sqlasapi
+
+import "testing"
+
+func TestDialects(t *testing.T) {
+	tests := []struct {
+		name            string
+		dialect         Dialect
+		wantIdent       string
+		wantPlaceholder string
+		wantLike        string
+		wantILike       string
+	}{
+		{
+			name:            "generic",
+			dialect:         GenericDialect{},
+			wantIdent:       "weight",
+			wantPlaceholder: "?",
+			wantLike:        "LIKE",
+			wantILike:       "LIKE",
+		},
+		{
+			name:            "postgres",
+			dialect:         PostgresDialect{},
+			wantIdent:       `"weight"`,
+			wantPlaceholder: "$2",
+			wantLike:        "LIKE",
+			wantILike:       "ILIKE",
+		},
+		{
+			name:            "mysql",
+			dialect:         MySQLDialect{},
+			wantIdent:       "`weight`",
+			wantPlaceholder: "?",
+			wantLike:        "LIKE",
+			wantILike:       "LIKE",
+		},
+		{
+			name:            "sqlite",
+			dialect:         SQLiteDialect{},
+			wantIdent:       `"weight"`,
+			wantPlaceholder: "?",
+			wantLike:        "LIKE",
+			wantILike:       "LIKE",
+		},
+		{
+			name:            "oracle",
+			dialect:         OracleDialect{},
+			wantIdent:       `"weight"`,
+			wantPlaceholder: ":2",
+			wantLike:        "LIKE",
+			wantILike:       "LIKE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dialect.QuoteIdent("weight"); got != tt.wantIdent {
+				t.Errorf("QuoteIdent() = %q, want %q", got, tt.wantIdent)
+			}
+			if got := tt.dialect.Placeholder(2); got != tt.wantPlaceholder {
+				t.Errorf("Placeholder(2) = %q, want %q", got, tt.wantPlaceholder)
+			}
+			if got := tt.dialect.Like(false); got != tt.wantLike {
+				t.Errorf("Like(false) = %q, want %q", got, tt.wantLike)
+			}
+			if got := tt.dialect.Like(true); got != tt.wantILike {
+				t.Errorf("Like(true) = %q, want %q", got, tt.wantILike)
+			}
+		})
+	}
+}
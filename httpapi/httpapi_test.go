@@ -0,0 +1,111 @@
+package httpapi
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appliedgo/sqlasapi"
+)
+
+// stubDriver is a database/sql/driver.Driver that records the last query it was asked to
+// prepare and returns no rows, so ServeHTTP can be exercised end to end without a real database.
+type stubDriver struct{ lastQuery string }
+
+func (d *stubDriver) Open(name string) (driver.Conn, error) { return &stubConn{d}, nil }
+
+type stubConn struct{ d *stubDriver }
+
+func (c *stubConn) Prepare(query string) (driver.Stmt, error) {
+	c.d.lastQuery = query
+	return &stubStmt{}, nil
+}
+func (c *stubConn) Close() error              { return nil }
+func (c *stubConn) Begin() (driver.Tx, error) { return nil, sql.ErrTxDone }
+
+type stubStmt struct{}
+
+func (stubStmt) Close() error                                    { return nil }
+func (stubStmt) NumInput() int                                   { return -1 }
+func (stubStmt) Exec(args []driver.Value) (driver.Result, error) { return nil, sql.ErrTxDone }
+func (stubStmt) Query(args []driver.Value) (driver.Rows, error)  { return stubRows{}, nil }
+
+type stubRows struct{}
+
+func (stubRows) Columns() []string              { return []string{"id"} }
+func (stubRows) Close() error                   { return nil }
+func (stubRows) Next(dest []driver.Value) error { return io.EOF }
+
+func TestOrderByClause(t *testing.T) {
+	columns := map[string]sqlasapi.ColumnSpec{"weight": {Type: sqlasapi.ColumnTypeInt}}
+
+	tests := []struct {
+		name    string
+		sort    string
+		dialect sqlasapi.Dialect
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", sort: "", want: ""},
+		{name: "ascending default", sort: "weight", want: " ORDER BY weight ASC"},
+		{name: "explicit ascending", sort: "+weight", want: " ORDER BY weight ASC"},
+		{name: "descending", sort: "-weight", want: " ORDER BY weight DESC"},
+		{name: "unknown column", sort: "retail_price", wantErr: true},
+		{name: "nil dialect defaults to generic", sort: "weight", dialect: nil, want: " ORDER BY weight ASC"},
+		{name: "postgres quotes the column", sort: "weight", dialect: sqlasapi.PostgresDialect{}, want: ` ORDER BY "weight" ASC`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := orderByClause(tt.sort, columns, tt.dialect)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("orderByClause() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("orderByClause() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandlerServeHTTPSkipsSanitizeOnEmptyWhere(t *testing.T) {
+	d := &stubDriver{}
+	sql.Register("httpapi-test-empty-where", d)
+	db, err := sql.Open("httpapi-test-empty-where", "")
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+
+	h := &Handler{
+		Table: "bicycles",
+		DB:    db,
+		Config: sqlasapi.Config{
+			Columns: map[string]sqlasapi.ColumnSpec{"weight": {Type: sqlasapi.ColumnTypeInt}},
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"sort":"weight","limit":10,"offset":5}`))
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != 200 {
+		t.Fatalf("ServeHTTP() with empty where: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	want := "SELECT * FROM bicycles ORDER BY weight ASC LIMIT 10 OFFSET 5"
+	if d.lastQuery != want {
+		t.Errorf("query sent to DB = %q, want %q", d.lastQuery, want)
+	}
+}
+
+func TestHandlerServeHTTPRejectsGet(t *testing.T) {
+	h := &Handler{Table: "bicycles"}
+	// ServeHTTP's method check runs before it touches h.DB, so a nil DB is fine here.
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	if rec.Code != 405 {
+		t.Errorf("ServeHTTP() with GET: status = %d, want 405", rec.Code)
+	}
+}
@@ -0,0 +1,130 @@
+package sqlasapi
+
+import "fmt"
+
+// Limits caps the structural complexity of a where clause's AST, so that a deeply nested
+// AND/OR tree, a huge IN list, or a pile of long string literals cannot be used to build a
+// pathologically expensive query. Sanitize checks these, once, before rendering the clause -
+// necessary once where clauses can come from untrusted API clients rather than from code the
+// same team wrote.
+//
+// A zero field means "no limit" on that dimension.
+type Limits struct {
+	// MaxDepth caps how deeply expressions may nest (AND/OR/NOT/parentheses).
+	MaxDepth int
+	// MaxNodes caps the total number of AST nodes the where clause may contain.
+	MaxNodes int
+	// MaxPredicatesPerColumn caps how many Equals/Between/In/Like/Compare predicates may
+	// reference any single column.
+	MaxPredicatesPerColumn int
+	// MaxStringLiteralLen caps the length of any single string literal (an Equals value, an In
+	// value, or a Like pattern).
+	MaxStringLiteralLen int
+	// MaxInValues caps how many values a single In expression's list may contain.
+	MaxInValues int
+}
+
+// LimitError reports that a where clause exceeded one of the Limits checks.
+type LimitError struct {
+	// Limit names the Limits field that was tripped, e.g. "MaxDepth".
+	Limit string
+	// Got and Want are the observed and allowed values.
+	Got, Want int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("where clause exceeds %s: got %d, want at most %d", e.Limit, e.Got, e.Want)
+}
+
+// checkLimits walks expr once and returns a *LimitError identifying the first limit it
+// exceeds, or nil if expr satisfies every non-zero field of limits. This includes the
+// cardinality of In's value list, which otherwise counts as a single node and a single
+// predicate no matter how many values it holds.
+func checkLimits(expr Expr, limits Limits) error {
+	nodes := 0
+	predicates := map[string]int{}
+
+	checkStringLen := func(s string) error {
+		if limits.MaxStringLiteralLen > 0 && len(s) > limits.MaxStringLiteralLen {
+			return &LimitError{Limit: "MaxStringLiteralLen", Got: len(s), Want: limits.MaxStringLiteralLen}
+		}
+		return nil
+	}
+	checkValue := func(v Value) error {
+		if sv, ok := v.(StringValue); ok {
+			return checkStringLen(sv.Value)
+		}
+		return nil
+	}
+	countPredicate := func(column string) error {
+		predicates[column]++
+		if limits.MaxPredicatesPerColumn > 0 && predicates[column] > limits.MaxPredicatesPerColumn {
+			return &LimitError{Limit: "MaxPredicatesPerColumn", Got: predicates[column], Want: limits.MaxPredicatesPerColumn}
+		}
+		return nil
+	}
+
+	var walk func(e Expr, depth int) error
+	walk = func(e Expr, depth int) error {
+		nodes++
+		if limits.MaxNodes > 0 && nodes > limits.MaxNodes {
+			return &LimitError{Limit: "MaxNodes", Got: nodes, Want: limits.MaxNodes}
+		}
+		if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+			return &LimitError{Limit: "MaxDepth", Got: depth, Want: limits.MaxDepth}
+		}
+
+		switch e := e.(type) {
+		case And:
+			if err := walk(e.Left, depth+1); err != nil {
+				return err
+			}
+			return walk(e.Right, depth+1)
+		case Or:
+			if err := walk(e.Left, depth+1); err != nil {
+				return err
+			}
+			return walk(e.Right, depth+1)
+		case Not:
+			return walk(e.Expr, depth+1)
+		case Parenthesis:
+			return walk(e.Expr, depth+1)
+		case Column:
+			return nil
+		case Equals:
+			if err := countPredicate(e.Column.Name); err != nil {
+				return err
+			}
+			return checkValue(e.Value)
+		case Between:
+			return countPredicate(e.Column.Name)
+		case In:
+			if err := countPredicate(e.Column.Name); err != nil {
+				return err
+			}
+			if limits.MaxInValues > 0 && len(e.Values) > limits.MaxInValues {
+				return &LimitError{Limit: "MaxInValues", Got: len(e.Values), Want: limits.MaxInValues}
+			}
+			for _, v := range e.Values {
+				if err := checkValue(v); err != nil {
+					return err
+				}
+			}
+			return nil
+		case Like:
+			if err := countPredicate(e.Column.Name); err != nil {
+				return err
+			}
+			return checkStringLen(e.Pattern.Value)
+		case Compare:
+			if err := countPredicate(e.Column.Name); err != nil {
+				return err
+			}
+			return checkValue(e.Value)
+		default:
+			return nil
+		}
+	}
+
+	return walk(expr, 1)
+}
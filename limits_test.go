@@ -0,0 +1,80 @@
+package sqlasapi
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckLimits(t *testing.T) {
+	deepAnd := Expr(Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel"}})
+	for i := 0; i < 5; i++ {
+		deepAnd = And{Left: deepAnd, Right: Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel"}}}
+	}
+
+	tests := []struct {
+		name      string
+		expr      Expr
+		limits    Limits
+		wantLimit string
+	}{
+		{
+			name:   "within all limits",
+			expr:   Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel"}},
+			limits: Limits{MaxDepth: 3, MaxNodes: 10, MaxPredicatesPerColumn: 2, MaxStringLiteralLen: 20},
+		},
+		{
+			name:      "max depth exceeded",
+			expr:      deepAnd,
+			limits:    Limits{MaxDepth: 3},
+			wantLimit: "MaxDepth",
+		},
+		{
+			name:      "max nodes exceeded",
+			expr:      deepAnd,
+			limits:    Limits{MaxNodes: 3},
+			wantLimit: "MaxNodes",
+		},
+		{
+			name: "max predicates per column exceeded",
+			expr: And{
+				Left:  Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel"}},
+				Right: Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "carbon"}},
+			},
+			limits:    Limits{MaxPredicatesPerColumn: 1},
+			wantLimit: "MaxPredicatesPerColumn",
+		},
+		{
+			name:      "max string literal length exceeded",
+			expr:      Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steeeeeeeeeel"}},
+			limits:    Limits{MaxStringLiteralLen: 5},
+			wantLimit: "MaxStringLiteralLen",
+		},
+		{
+			name: "max in values exceeded",
+			expr: In{Column: Column{Name: "material"}, Values: []Value{
+				IntegerValue{Value: 1}, IntegerValue{Value: 2}, IntegerValue{Value: 3},
+			}},
+			limits:    Limits{MaxInValues: 2},
+			wantLimit: "MaxInValues",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkLimits(tt.expr, tt.limits)
+			if tt.wantLimit == "" {
+				if err != nil {
+					t.Fatalf("checkLimits() error = %v, want nil", err)
+				}
+				return
+			}
+			var limitErr *LimitError
+			if !errors.As(err, &limitErr) {
+				t.Fatalf("checkLimits() error = %v, want a *LimitError", err)
+			}
+			if limitErr.Limit != tt.wantLimit {
+				t.Errorf("checkLimits() tripped %q, want %q", limitErr.Limit, tt.wantLimit)
+			}
+		})
+	}
+}
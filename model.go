@@ -0,0 +1,111 @@
+package sqlasapi
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ColumnType is the SQL value type a whitelisted column accepts.
+type ColumnType int
+
+const (
+	// ColumnTypeString accepts only StringValue.
+	ColumnTypeString ColumnType = iota
+	// ColumnTypeInt accepts only IntegerValue.
+	ColumnTypeInt
+)
+
+// ColumnSpec describes one whitelisted column: the SQL type it holds and, for integer columns,
+// the inclusive range a Between clause may reference. RegisterModel builds these from a
+// struct's `sql` tags; Config.Columns is a map of column name to ColumnSpec.
+type ColumnSpec struct {
+	Type ColumnType
+
+	// HasRange reports whether Min/Max were declared (via the `min`/`max` tag options) and
+	// should be enforced on Between clauses. Equals is not range-checked.
+	HasRange bool
+	Min, Max int
+
+	// Ops restricts which operators this column may be used with. A nil Ops preserves the
+	// historical default of Equals and Between only; set it explicitly to opt a column into
+	// OpLike, OpIn, or the comparison operators.
+	Ops []Operator
+
+	// AllowLeadingWildcard allows a LIKE pattern on this column to start with `%`. Leading
+	// wildcards defeat index usage and commonly cause full-table scans, so this is off by
+	// default and must be opted into per column.
+	AllowLeadingWildcard bool
+}
+
+// RegisterModel reflects over v, a struct (or pointer to struct), and builds a Config whose
+// Columns whitelist is derived from each field's `sql` struct tag. A tag has the form
+// `sql:"name"` or, for integer fields that should also enforce a range on Between clauses,
+// `sql:"name,min=0,max=100"`. Fields without a `sql` tag are ignored.
+//
+// This turns the columns whitelist from a bag of names into a real schema: Equals and Between
+// can now reject values of the wrong type, and Between can reject bounds outside the declared
+// range, catching the type-confusion attacks a plain map[string]struct{} whitelist lets through.
+func RegisterModel(v any) Config {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("sqlasapi.RegisterModel: %s is not a struct", t))
+	}
+
+	columns := map[string]ColumnSpec{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, ok := field.Tag.Lookup("sql")
+		if !ok {
+			continue
+		}
+		name, spec := parseSqlTag(tag, field.Type)
+		columns[name] = spec
+	}
+
+	return Config{Columns: columns}
+}
+
+// parseSqlTag parses a `sql:"name,min=0,max=100"`-style tag for a field of the given Go type
+// into the column name it whitelists and the resulting ColumnSpec.
+func parseSqlTag(tag string, fieldType reflect.Type) (string, ColumnSpec) {
+	parts := strings.Split(tag, ",")
+	name := parts[0]
+
+	spec := ColumnSpec{Type: columnTypeOf(fieldType)}
+	for _, opt := range parts[1:] {
+		key, value, ok := strings.Cut(opt, "=")
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			continue
+		}
+		switch key {
+		case "min":
+			spec.HasRange = true
+			spec.Min = n
+		case "max":
+			spec.HasRange = true
+			spec.Max = n
+		}
+	}
+	return name, spec
+}
+
+// columnTypeOf maps a Go field type to the ColumnType it whitelists as. Integer kinds become
+// ColumnTypeInt, everything else (in practice: string) becomes ColumnTypeString.
+func columnTypeOf(t reflect.Type) ColumnType {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return ColumnTypeInt
+	default:
+		return ColumnTypeString
+	}
+}
@@ -0,0 +1,61 @@
+package sqlasapi
+
+import (
+	"reflect"
+	"testing"
+)
+
+type Bicycle struct {
+	Material string `sql:"material"`
+	Weight   int    `sql:"weight,min=0,max=100"`
+	internal string
+}
+
+func TestRegisterModel(t *testing.T) {
+	cfg := RegisterModel(Bicycle{})
+
+	want := map[string]ColumnSpec{
+		"material": {Type: ColumnTypeString},
+		"weight":   {Type: ColumnTypeInt, HasRange: true, Min: 0, Max: 100},
+	}
+	if len(cfg.Columns) != len(want) {
+		t.Fatalf("RegisterModel() columns = %#v, want %#v", cfg.Columns, want)
+	}
+	for name, spec := range want {
+		// ColumnSpec now holds Ops, a slice, so it is no longer comparable with !=.
+		if got := cfg.Columns[name]; !reflect.DeepEqual(got, spec) {
+			t.Errorf("RegisterModel() columns[%q] = %#v, want %#v", name, got, spec)
+		}
+	}
+	if _, ok := cfg.Columns["internal"]; ok {
+		t.Error("RegisterModel() included a field with no `sql` tag")
+	}
+}
+
+func TestRegisterModelEnforcesType(t *testing.T) {
+	cfg := RegisterModel(Bicycle{})
+	cfg.Parser = stubParser{Equals{Column: Column{Name: "material"}, Value: IntegerValue{Value: 1}}}
+
+	if _, _, err := Sanitize("material = 1", cfg); err == nil {
+		t.Error("Sanitize() with an integer value on a string column: want error, got nil")
+	}
+}
+
+func TestRegisterModelEnforcesRange(t *testing.T) {
+	cfg := RegisterModel(Bicycle{})
+	cfg.Parser = stubParser{Between{Column: Column{Name: "weight"}, Lower: -5, Upper: 20}}
+
+	if _, _, err := Sanitize("weight between -5 and 20", cfg); err == nil {
+		t.Error("Sanitize() with bounds outside the declared range: want error, got nil")
+	}
+}
+
+// stubParser is a Parser that ignores its input and always returns expr, used to test
+// processSqlExprParams validation without going through the real SQL parser.
+type stubParser struct {
+	expr Expr
+}
+
+func (p stubParser) Parse(string) (Expr, error) {
+	return p.expr, nil
+}
@@ -0,0 +1,119 @@
+package sqlasapi
+
+// Operator enumerates the kinds of predicate a column can be used with, for the purpose of
+// per-column allow-lists: ColumnSpec.Ops lists which of these a given column permits, so e.g.
+// LIKE can be enabled on a `material` column but withheld from a `serial_number` column.
+type Operator int
+
+const (
+	// OpEquals is the `=` operator.
+	OpEquals Operator = iota
+	// OpBetween is the `BETWEEN ... AND ...` operator.
+	OpBetween
+	// OpLike is the `LIKE` operator.
+	OpLike
+	// OpIn is the `IN (...)` operator.
+	OpIn
+	// OpLessThan is the `<` operator.
+	OpLessThan
+	// OpLessOrEqual is the `<=` operator.
+	OpLessOrEqual
+	// OpGreaterThan is the `>` operator.
+	OpGreaterThan
+	// OpGreaterOrEqual is the `>=` operator.
+	OpGreaterOrEqual
+	// OpNotEqual is the `!=` operator.
+	OpNotEqual
+)
+
+// CompareOp is the operator of a Compare expression.
+type CompareOp int
+
+const (
+	// OpLT renders as `<`.
+	OpLT CompareOp = iota
+	// OpLE renders as `<=`.
+	OpLE
+	// OpGT renders as `>`.
+	OpGT
+	// OpGE renders as `>=`.
+	OpGE
+	// OpNE renders as `!=`.
+	OpNE
+)
+
+// sql renders op as its SQL symbol.
+func (op CompareOp) sql() string {
+	switch op {
+	case OpLT:
+		return "<"
+	case OpLE:
+		return "<="
+	case OpGT:
+		return ">"
+	case OpGE:
+		return ">="
+	case OpNE:
+		return "!="
+	default:
+		return "?"
+	}
+}
+
+// operator maps op to the Operator used for column allow-list checks.
+func (op CompareOp) operator() Operator {
+	switch op {
+	case OpLT:
+		return OpLessThan
+	case OpLE:
+		return OpLessOrEqual
+	case OpGT:
+		return OpGreaterThan
+	case OpGE:
+		return OpGreaterOrEqual
+	default:
+		return OpNotEqual
+	}
+}
+
+// Not negates its operand: `NOT (...)`.
+type Not struct {
+	Expr Expr
+}
+
+// In checks whether a column's value is one of a fixed set: `column IN (v1, v2, ...)`.
+type In struct {
+	Column Column
+	Values []Value
+}
+
+// Like performs a SQL LIKE pattern match: `column LIKE pattern`. CaseInsensitive asks the
+// configured Dialect for a case-insensitive match (e.g. Postgres's ILIKE) where it supports one.
+type Like struct {
+	Column          Column
+	Pattern         StringValue
+	CaseInsensitive bool
+}
+
+// Compare applies a comparison operator - `<`, `<=`, `>`, `>=`, or `!=` - between a column and a
+// value. (`=` stays modeled as Equals, for backward compatibility.)
+type Compare struct {
+	Column Column
+	Op     CompareOp
+	Value  Value
+}
+
+// allows reports whether spec permits op. A nil Ops preserves the historical default: only
+// Equals and Between are allowed, matching the behavior before per-column operator allow-lists
+// existed.
+func (spec ColumnSpec) allows(op Operator) bool {
+	if spec.Ops == nil {
+		return op == OpEquals || op == OpBetween
+	}
+	for _, allowed := range spec.Ops {
+		if allowed == op {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package sqlasapi
+
+import "testing"
+
+func allOpsColumns() map[string]ColumnSpec {
+	return map[string]ColumnSpec{
+		"material": {Type: ColumnTypeString, Ops: []Operator{OpEquals, OpIn, OpLike}},
+		"weight":   {Type: ColumnTypeInt, Ops: []Operator{OpEquals, OpBetween, OpLessThan, OpLessOrEqual, OpGreaterThan, OpGreaterOrEqual, OpNotEqual}},
+	}
+}
+
+func TestProcessSqlExprParamsOperators(t *testing.T) {
+	tests := []struct {
+		name                string
+		expr                Expr
+		dialect             Dialect
+		allowOr             bool
+		allowLike           bool
+		caseInsensitiveLike bool
+		wantSQL             string
+		wantArgs            []any
+		wantErr             bool
+	}{
+		{
+			name: "or requires AllowOr",
+			expr: Or{
+				Left:  Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel"}},
+				Right: Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "carbon"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "or allowed",
+			expr: Or{
+				Left:  Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel"}},
+				Right: Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "carbon"}},
+			},
+			allowOr:  true,
+			wantSQL:  "material = ? OR material = ?",
+			wantArgs: []any{"steel", "carbon"},
+		},
+		{
+			name:     "not",
+			expr:     Not{Expr: Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel"}}},
+			wantSQL:  "NOT material = ?",
+			wantArgs: []any{"steel"},
+		},
+		{
+			name: "in",
+			expr: In{Column: Column{Name: "material"}, Values: []Value{
+				StringValue{Value: "steel"}, StringValue{Value: "carbon"},
+			}},
+			wantSQL:  "material IN (?, ?)",
+			wantArgs: []any{"steel", "carbon"},
+		},
+		{
+			name:    "like requires AllowLike",
+			expr:    Like{Column: Column{Name: "material"}, Pattern: StringValue{Value: "steel%"}},
+			wantErr: true,
+		},
+		{
+			name:      "like allowed",
+			expr:      Like{Column: Column{Name: "material"}, Pattern: StringValue{Value: "steel%"}},
+			allowLike: true,
+			wantSQL:   "material LIKE ?",
+			wantArgs:  []any{"steel%"},
+		},
+		{
+			name:      "like with leading wildcard rejected",
+			expr:      Like{Column: Column{Name: "material"}, Pattern: StringValue{Value: "%steel"}},
+			allowLike: true,
+			wantErr:   true,
+		},
+		{
+			name:                "like case-insensitive via Config.CaseInsensitiveLike",
+			expr:                Like{Column: Column{Name: "material"}, Pattern: StringValue{Value: "steel%"}},
+			dialect:             PostgresDialect{},
+			allowLike:           true,
+			caseInsensitiveLike: true,
+			wantSQL:             `"material" ILIKE $1`,
+			wantArgs:            []any{"steel%"},
+		},
+		{
+			name:     "compare less than",
+			expr:     Compare{Column: Column{Name: "weight"}, Op: OpLT, Value: IntegerValue{Value: 20}},
+			wantSQL:  "weight < ?",
+			wantArgs: []any{20},
+		},
+		{
+			name:     "compare not equal",
+			expr:     Compare{Column: Column{Name: "weight"}, Op: OpNE, Value: IntegerValue{Value: 20}},
+			wantSQL:  "weight != ?",
+			wantArgs: []any{20},
+		},
+		{
+			name:    "operator not allowed for column",
+			expr:    Compare{Column: Column{Name: "material"}, Op: OpLT, Value: StringValue{Value: "steel"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newParamBuilder(tt.dialect, tt.allowOr, tt.allowLike, tt.caseInsensitiveLike)
+			got, err := processSqlExprParams(tt.expr, allOpsColumns(), b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("processSqlExprParams() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.wantSQL {
+				t.Errorf("processSqlExprParams() sql = %q, want %q", got, tt.wantSQL)
+			}
+			if len(b.args) != len(tt.wantArgs) {
+				t.Fatalf("processSqlExprParams() args = %#v, want %#v", b.args, tt.wantArgs)
+			}
+			for i := range b.args {
+				if b.args[i] != tt.wantArgs[i] {
+					t.Errorf("processSqlExprParams() args[%d] = %#v, want %#v", i, b.args[i], tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
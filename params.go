@@ -0,0 +1,213 @@
+package sqlasapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// paramBuilder accumulates bind-variable args while processSqlExprParams walks the AST, so
+// nested recursive calls can share one growing args slice and one placeholder counter. It also
+// renders dialect-specific identifier quoting and placeholder/LIKE syntax.
+type paramBuilder struct {
+	dialect Dialect
+	args    []any
+
+	// allowOr and allowLike mirror Config.AllowOr and Config.AllowLike: OR and LIKE are
+	// powerful enough to widen a query well beyond what a column whitelist anticipates, so
+	// they must be switched on explicitly.
+	allowOr   bool
+	allowLike bool
+
+	// caseInsensitiveLike mirrors Config.CaseInsensitiveLike: it forces every Like in this
+	// where clause to match case-insensitively (e.g. Postgres ILIKE), regardless of whether the
+	// individual Like node's CaseInsensitive field was set by the caller's Parser.
+	caseInsensitiveLike bool
+}
+
+// newParamBuilder returns a paramBuilder for the given dialect, defaulting to GenericDialect
+// when dialect is nil.
+func newParamBuilder(dialect Dialect, allowOr, allowLike, caseInsensitiveLike bool) *paramBuilder {
+	if dialect == nil {
+		dialect = GenericDialect{}
+	}
+	return &paramBuilder{dialect: dialect, allowOr: allowOr, allowLike: allowLike, caseInsensitiveLike: caseInsensitiveLike}
+}
+
+// bind appends v to the accumulated args and returns the placeholder token referring to it.
+func (b *paramBuilder) bind(v any) string {
+	b.args = append(b.args, v)
+	return b.dialect.Placeholder(len(b.args))
+}
+
+// ident quotes name per the builder's dialect.
+func (b *paramBuilder) ident(name string) string {
+	return b.dialect.QuoteIdent(name)
+}
+
+// processSqlExprParams is processSqlExpr's parameterized sibling: instead of inlining string
+// and integer literals into the SQL text (which, for StringValue, never escaped embedded single
+// quotes), it emits a placeholder for every value and returns the value itself via b.args. The
+// returned sql is safe to use as-is with database/sql's db.Query(sql, args...).
+//
+// columns is now a schema, not just a whitelist: Equals and Between check the referenced
+// column's ColumnSpec and reject values of the wrong type or, for Between, bounds outside the
+// column's declared range. Identifier quoting, placeholder syntax, and LIKE/ILIKE all come from
+// b's Dialect, so the same Expr tree renders correctly for any backend a Dialect exists for.
+func processSqlExprParams(expr Expr, columns map[string]ColumnSpec, b *paramBuilder) (string, error) {
+	switch e := expr.(type) {
+	case Column:
+		if _, ok := columns[e.Name]; !ok {
+			return "", fmt.Errorf("column %s is unknown and not supported", e.Name)
+		}
+		return b.ident(e.Name), nil
+	case And:
+		left, err := processSqlExprParams(e.Left, columns, b)
+		if err != nil {
+			return "", fmt.Errorf("case And -> e.Left: %w", err)
+		}
+		right, err := processSqlExprParams(e.Right, columns, b)
+		if err != nil {
+			return "", fmt.Errorf("case And -> e.Right: %w", err)
+		}
+		return fmt.Sprintf("%s AND %s", left, right), nil
+	case Or:
+		if !b.allowOr {
+			return "", fmt.Errorf("OR clauses are not enabled (set Config.AllowOr to enable)")
+		}
+		left, err := processSqlExprParams(e.Left, columns, b)
+		if err != nil {
+			return "", fmt.Errorf("case Or -> e.Left: %w", err)
+		}
+		right, err := processSqlExprParams(e.Right, columns, b)
+		if err != nil {
+			return "", fmt.Errorf("case Or -> e.Right: %w", err)
+		}
+		return fmt.Sprintf("%s OR %s", left, right), nil
+	case Not:
+		inner, err := processSqlExprParams(e.Expr, columns, b)
+		if err != nil {
+			return "", fmt.Errorf("case Not: %w", err)
+		}
+		return fmt.Sprintf("NOT %s", inner), nil
+	case Between:
+		spec, err := lookupColumn(columns, e.Column.Name)
+		if err != nil {
+			return "", fmt.Errorf("case Between: %w", err)
+		}
+		if !spec.allows(OpBetween) {
+			return "", fmt.Errorf("case Between: column %s does not allow BETWEEN", e.Column.Name)
+		}
+		if spec.Type != ColumnTypeInt {
+			return "", fmt.Errorf("case Between: column %s does not accept integer bounds", e.Column.Name)
+		}
+		if spec.HasRange && (e.Lower < spec.Min || e.Upper > spec.Max) {
+			return "", fmt.Errorf("case Between: bounds [%d, %d] for column %s fall outside the allowed range [%d, %d]",
+				e.Lower, e.Upper, e.Column.Name, spec.Min, spec.Max)
+		}
+		lower := b.bind(e.Lower)
+		upper := b.bind(e.Upper)
+		return fmt.Sprintf("%s BETWEEN %s AND %s", b.ident(e.Column.Name), lower, upper), nil
+	case In:
+		spec, err := lookupColumn(columns, e.Column.Name)
+		if err != nil {
+			return "", fmt.Errorf("case In: %w", err)
+		}
+		if !spec.allows(OpIn) {
+			return "", fmt.Errorf("case In: column %s does not allow IN", e.Column.Name)
+		}
+		tokens := make([]string, 0, len(e.Values))
+		for _, v := range e.Values {
+			tok, err := processSqlValueParam(v, spec, b)
+			if err != nil {
+				return "", fmt.Errorf("case In -> value: %w", err)
+			}
+			tokens = append(tokens, tok)
+		}
+		return fmt.Sprintf("%s IN (%s)", b.ident(e.Column.Name), strings.Join(tokens, ", ")), nil
+	case Like:
+		if !b.allowLike {
+			return "", fmt.Errorf("LIKE clauses are not enabled (set Config.AllowLike to enable)")
+		}
+		spec, err := lookupColumn(columns, e.Column.Name)
+		if err != nil {
+			return "", fmt.Errorf("case Like: %w", err)
+		}
+		if !spec.allows(OpLike) {
+			return "", fmt.Errorf("case Like: column %s does not allow LIKE", e.Column.Name)
+		}
+		if strings.HasPrefix(e.Pattern.Value, "%") && !spec.AllowLeadingWildcard {
+			return "", fmt.Errorf("case Like: column %s does not allow a leading wildcard (commonly causes a full table scan)", e.Column.Name)
+		}
+		tok, err := processSqlValueParam(e.Pattern, spec, b)
+		if err != nil {
+			return "", fmt.Errorf("case Like -> pattern: %w", err)
+		}
+		return fmt.Sprintf("%s %s %s", b.ident(e.Column.Name), b.dialect.Like(e.CaseInsensitive || b.caseInsensitiveLike), tok), nil
+	case Compare:
+		spec, err := lookupColumn(columns, e.Column.Name)
+		if err != nil {
+			return "", fmt.Errorf("case Compare: %w", err)
+		}
+		if !spec.allows(e.Op.operator()) {
+			return "", fmt.Errorf("case Compare: column %s does not allow operator %s", e.Column.Name, e.Op.sql())
+		}
+		value, err := processSqlValueParam(e.Value, spec, b)
+		if err != nil {
+			return "", fmt.Errorf("case Compare -> e.Value: %w", err)
+		}
+		return fmt.Sprintf("%s %s %s", b.ident(e.Column.Name), e.Op.sql(), value), nil
+	case Parenthesis:
+		switch e.Expr.(type) {
+		case Parenthesis:
+			e = e.Expr.(Parenthesis)
+		}
+		inner, err := processSqlExprParams(e.Expr, columns, b)
+		if err != nil {
+			return "", fmt.Errorf("case Parenthesis: %w", err)
+		}
+		return fmt.Sprintf("(%s)", inner), nil
+	case Equals:
+		spec, err := lookupColumn(columns, e.Column.Name)
+		if err != nil {
+			return "", fmt.Errorf("case Equals: %w", err)
+		}
+		if !spec.allows(OpEquals) {
+			return "", fmt.Errorf("case Equals: column %s does not allow =", e.Column.Name)
+		}
+		value, err := processSqlValueParam(e.Value, spec, b)
+		if err != nil {
+			return "", fmt.Errorf("case Equals -> e.Value: %w", err)
+		}
+		return fmt.Sprintf("%s = %s", b.ident(e.Column.Name), value), nil
+	default:
+		return "", fmt.Errorf("unsupported expr type: %T", expr)
+	}
+}
+
+// lookupColumn returns the ColumnSpec for name, or an error if name is not whitelisted.
+func lookupColumn(columns map[string]ColumnSpec, name string) (ColumnSpec, error) {
+	spec, ok := columns[name]
+	if !ok {
+		return ColumnSpec{}, fmt.Errorf("column %s is unknown and not supported", name)
+	}
+	return spec, nil
+}
+
+// processSqlValueParam checks value against spec's declared type, binds it into b, and returns
+// its placeholder token.
+func processSqlValueParam(value Value, spec ColumnSpec, b *paramBuilder) (string, error) {
+	switch v := value.(type) {
+	case StringValue:
+		if spec.Type != ColumnTypeString {
+			return "", fmt.Errorf("string value %q is not allowed for a non-string column", v.Value)
+		}
+		return b.bind(v.Value), nil
+	case IntegerValue:
+		if spec.Type != ColumnTypeInt {
+			return "", fmt.Errorf("integer value %d is not allowed for a non-integer column", v.Value)
+		}
+		return b.bind(v.Value), nil
+	default:
+		return "", fmt.Errorf("unsupported value type: %T", value)
+	}
+}
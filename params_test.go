@@ -0,0 +1,67 @@
+package sqlasapi
+
+import "testing"
+
+func TestProcessSqlExprParams(t *testing.T) {
+	columns := map[string]ColumnSpec{
+		"material": {Type: ColumnTypeString},
+		"weight":   {Type: ColumnTypeInt},
+	}
+
+	tests := []struct {
+		name     string
+		expr     Expr
+		dialect  Dialect
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "generic dialect: no quoting, question placeholders",
+			expr:     Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel"}},
+			dialect:  nil,
+			wantSQL:  "material = ?",
+			wantArgs: []any{"steel"},
+		},
+		{
+			name:     "postgres dialect: quoted identifiers, dollar placeholders",
+			expr:     Between{Column: Column{Name: "weight"}, Lower: 10, Upper: 20},
+			dialect:  PostgresDialect{},
+			wantSQL:  `"weight" BETWEEN $1 AND $2`,
+			wantArgs: []any{10, 20},
+		},
+		{
+			name:     "mysql dialect: backtick-quoted identifiers, question placeholders",
+			expr:     Between{Column: Column{Name: "weight"}, Lower: 10, Upper: 20},
+			dialect:  MySQLDialect{},
+			wantSQL:  "`weight` BETWEEN ? AND ?",
+			wantArgs: []any{10, 20},
+		},
+		{
+			name:     "a string value containing a single quote is never inlined",
+			expr:     Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel' OR '1'='1"}},
+			wantSQL:  "material = ?",
+			wantArgs: []any{"steel' OR '1'='1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newParamBuilder(tt.dialect, false, false, false)
+			got, err := processSqlExprParams(tt.expr, columns, b)
+			if err != nil {
+				t.Fatalf("processSqlExprParams() error = %v", err)
+			}
+			if got != tt.wantSQL {
+				t.Errorf("processSqlExprParams() sql = %q, want %q", got, tt.wantSQL)
+			}
+			if len(b.args) != len(tt.wantArgs) {
+				t.Fatalf("processSqlExprParams() args = %#v, want %#v", b.args, tt.wantArgs)
+			}
+			for i := range b.args {
+				if b.args[i] != tt.wantArgs[i] {
+					t.Errorf("processSqlExprParams() args[%d] = %#v, want %#v", i, b.args[i], tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
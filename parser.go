@@ -0,0 +1,205 @@
+// Package sqlasapi started from an imaginary SQL parser (see sqlasapi.go). This file replaces
+// the imagination with a real one: github.com/xwb1989/sqlparser, the parser Vitess uses in
+// production, already speaks full SQL and hands back an AST we can walk.
+package sqlasapi
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/xwb1989/sqlparser"
+)
+
+// Parser turns a raw `where` clause string into this package's `Expr` tree. It is the seam
+// between "some SQL parser" and the validation/rendering logic in processSqlExprParams, so a
+// different parser library can be dropped in without touching the rest of the package.
+type Parser interface {
+	Parse(where string) (Expr, error)
+}
+
+// SqlparserParser implements Parser on top of github.com/xwb1989/sqlparser.
+type SqlparserParser struct{}
+
+// Parse parses where as a standalone SQL `where` clause. Since sqlparser only parses full
+// statements, where is wrapped in a throwaway `select * from t where ...` first.
+func (SqlparserParser) Parse(where string) (Expr, error) {
+	stmt, err := sqlparser.Parse("select * from t where " + where)
+	if err != nil {
+		return nil, fmt.Errorf("parsing where clause: %w", err)
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where == nil {
+		return nil, fmt.Errorf("expected a where clause, got %T", stmt)
+	}
+	return convertExpr(sel.Where.Expr)
+}
+
+// convertExpr walks a github.com/xwb1989/sqlparser expression tree and converts the subset of
+// it that sqlasapi understands into this package's Expr tree.
+func convertExpr(e sqlparser.Expr) (Expr, error) {
+	switch e := e.(type) {
+	case *sqlparser.AndExpr:
+		left, err := convertExpr(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := convertExpr(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return And{Left: left, Right: right}, nil
+	case *sqlparser.OrExpr:
+		left, err := convertExpr(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := convertExpr(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return Or{Left: left, Right: right}, nil
+	case *sqlparser.ParenExpr:
+		inner, err := convertExpr(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return Parenthesis{Expr: inner}, nil
+	case *sqlparser.RangeCond:
+		if e.Operator != sqlparser.BetweenStr {
+			return nil, fmt.Errorf("unsupported range operator: %s", e.Operator)
+		}
+		col, err := convertColName(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		lower, err := convertIntLiteral(e.From)
+		if err != nil {
+			return nil, err
+		}
+		upper, err := convertIntLiteral(e.To)
+		if err != nil {
+			return nil, err
+		}
+		return Between{Column: col, Lower: lower, Upper: upper}, nil
+	case *sqlparser.NotExpr:
+		inner, err := convertExpr(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return Not{Expr: inner}, nil
+	case *sqlparser.ComparisonExpr:
+		col, err := convertColName(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		switch e.Operator {
+		case sqlparser.EqualStr:
+			val, err := convertValue(e.Right)
+			if err != nil {
+				return nil, err
+			}
+			return Equals{Column: col, Value: val}, nil
+		case sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr, sqlparser.NotEqualStr:
+			val, err := convertValue(e.Right)
+			if err != nil {
+				return nil, err
+			}
+			return Compare{Column: col, Op: compareOpFor(e.Operator), Value: val}, nil
+		case sqlparser.LikeStr:
+			val, err := convertValue(e.Right)
+			if err != nil {
+				return nil, err
+			}
+			pattern, ok := val.(StringValue)
+			if !ok {
+				return nil, fmt.Errorf("LIKE pattern must be a string literal, got %T", val)
+			}
+			return Like{Column: col, Pattern: pattern}, nil
+		case sqlparser.InStr:
+			values, err := convertValueTuple(e.Right)
+			if err != nil {
+				return nil, err
+			}
+			return In{Column: col, Values: values}, nil
+		default:
+			return nil, fmt.Errorf("unsupported comparison operator: %s", e.Operator)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported expression type: %T", e)
+	}
+}
+
+// compareOpFor maps a sqlparser comparison operator string to the corresponding CompareOp. It
+// is only called for the operators convertExpr has already matched, so it never needs a
+// not-found case.
+func compareOpFor(op string) CompareOp {
+	switch op {
+	case sqlparser.LessThanStr:
+		return OpLT
+	case sqlparser.LessEqualStr:
+		return OpLE
+	case sqlparser.GreaterThanStr:
+		return OpGT
+	case sqlparser.GreaterEqualStr:
+		return OpGE
+	default:
+		return OpNE
+	}
+}
+
+// convertValueTuple converts the right-hand side of an IN expression - a parenthesized list of
+// literals - into this package's Value slice.
+func convertValueTuple(e sqlparser.Expr) ([]Value, error) {
+	tuple, ok := e.(sqlparser.ValTuple)
+	if !ok {
+		return nil, fmt.Errorf("expected a list of values for IN, got %T", e)
+	}
+	values := make([]Value, 0, len(tuple))
+	for _, item := range tuple {
+		val, err := convertValue(item)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, val)
+	}
+	return values, nil
+}
+
+func convertColName(e sqlparser.Expr) (Column, error) {
+	col, ok := e.(*sqlparser.ColName)
+	if !ok {
+		return Column{}, fmt.Errorf("expected a column name, got %T", e)
+	}
+	return Column{Name: col.Name.String()}, nil
+}
+
+func convertIntLiteral(e sqlparser.Expr) (int, error) {
+	val, ok := e.(*sqlparser.SQLVal)
+	if !ok || val.Type != sqlparser.IntVal {
+		return 0, fmt.Errorf("expected an integer literal, got %T", e)
+	}
+	n, err := strconv.Atoi(string(val.Val))
+	if err != nil {
+		return 0, fmt.Errorf("parsing integer literal: %w", err)
+	}
+	return n, nil
+}
+
+func convertValue(e sqlparser.Expr) (Value, error) {
+	val, ok := e.(*sqlparser.SQLVal)
+	if !ok {
+		return nil, fmt.Errorf("expected a literal value, got %T", e)
+	}
+	switch val.Type {
+	case sqlparser.StrVal:
+		return StringValue{Value: string(val.Val)}, nil
+	case sqlparser.IntVal:
+		n, err := strconv.Atoi(string(val.Val))
+		if err != nil {
+			return nil, fmt.Errorf("parsing integer literal: %w", err)
+		}
+		return IntegerValue{Value: n}, nil
+	default:
+		return nil, fmt.Errorf("unsupported literal type: %v", val.Type)
+	}
+}
@@ -0,0 +1,216 @@
+package sqlasapi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSqlparserParser(t *testing.T) {
+	tests := []struct {
+		name    string
+		where   string
+		want    Expr
+		wantErr bool
+	}{
+		{
+			name:  "simple equals",
+			where: "material = 'steel'",
+			want:  Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel"}},
+		},
+		{
+			name:  "and of equals and between",
+			where: "material = 'steel' and weight between 10 and 20",
+			want: And{
+				Left:  Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel"}},
+				Right: Between{Column: Column{Name: "weight"}, Lower: 10, Upper: 20},
+			},
+		},
+		{
+			name:  "parenthesized or",
+			where: "(material = 'steel') or (material = 'carbon')",
+			want: Or{
+				Left:  Parenthesis{Expr: Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel"}}},
+				Right: Parenthesis{Expr: Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "carbon"}}},
+			},
+		},
+		{
+			name:    "syntax error",
+			where:   "material = ",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported operator",
+			where:   "weight <=> 10",
+			wantErr: true,
+		},
+		{
+			name:  "not",
+			where: "not material = 'steel'",
+			want:  Not{Expr: Equals{Column: Column{Name: "material"}, Value: StringValue{Value: "steel"}}},
+		},
+		{
+			name:  "greater than",
+			where: "weight > 10",
+			want:  Compare{Column: Column{Name: "weight"}, Op: OpGT, Value: IntegerValue{Value: 10}},
+		},
+		{
+			name:  "less than or equal",
+			where: "weight <= 10",
+			want:  Compare{Column: Column{Name: "weight"}, Op: OpLE, Value: IntegerValue{Value: 10}},
+		},
+		{
+			name:  "not equal",
+			where: "weight != 10",
+			want:  Compare{Column: Column{Name: "weight"}, Op: OpNE, Value: IntegerValue{Value: 10}},
+		},
+		{
+			name:  "like",
+			where: "material like 'steel%'",
+			want:  Like{Column: Column{Name: "material"}, Pattern: StringValue{Value: "steel%"}},
+		},
+		{
+			name:  "in",
+			where: "material in ('steel', 'carbon')",
+			want: In{Column: Column{Name: "material"}, Values: []Value{
+				StringValue{Value: "steel"}, StringValue{Value: "carbon"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (SqlparserParser{}).Parse(tt.where)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			// In holds a []Value, which makes some Expr values uncomparable with ==.
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	cfg := Config{Columns: map[string]ColumnSpec{
+		"material": {Type: ColumnTypeString},
+		"weight":   {Type: ColumnTypeInt},
+	}}
+
+	sql, args, err := Sanitize("material = 'steel' and weight between 10 and 20", cfg)
+	if err != nil {
+		t.Fatalf("Sanitize() error = %v", err)
+	}
+	want := "material = ? AND weight BETWEEN ? AND ?"
+	if sql != want {
+		t.Errorf("Sanitize() sql = %q, want %q", sql, want)
+	}
+	wantArgs := []any{"steel", 10, 20}
+	if len(args) != len(wantArgs) {
+		t.Fatalf("Sanitize() args = %#v, want %#v", args, wantArgs)
+	}
+	for i := range args {
+		if args[i] != wantArgs[i] {
+			t.Errorf("Sanitize() args[%d] = %#v, want %#v", i, args[i], wantArgs[i])
+		}
+	}
+
+	if _, _, err := Sanitize("retail_price = 100", cfg); err == nil {
+		t.Error("Sanitize() with unknown column: want error, got nil")
+	}
+}
+
+// TestSanitizeOperators exercises Not, Compare, Like, and In end-to-end through Sanitize, from
+// a raw where string all the way to rendered SQL - the path the parser previously couldn't
+// reach for anything but And/Between/Equals/Or.
+func TestSanitizeOperators(t *testing.T) {
+	cfg := Config{
+		AllowLike: true,
+		Columns: map[string]ColumnSpec{
+			"material": {Type: ColumnTypeString, Ops: []Operator{OpEquals, OpIn, OpLike}},
+			"weight":   {Type: ColumnTypeInt, Ops: []Operator{OpEquals, OpBetween, OpGreaterThan, OpLessOrEqual, OpNotEqual}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		where    string
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "not",
+			where:    "not material = 'steel'",
+			wantSQL:  "NOT material = ?",
+			wantArgs: []any{"steel"},
+		},
+		{
+			name:     "greater than",
+			where:    "weight > 10",
+			wantSQL:  "weight > ?",
+			wantArgs: []any{10},
+		},
+		{
+			name:     "like",
+			where:    "material like 'steel%'",
+			wantSQL:  "material LIKE ?",
+			wantArgs: []any{"steel%"},
+		},
+		{
+			name:     "in",
+			where:    "material in ('steel', 'carbon')",
+			wantSQL:  "material IN (?, ?)",
+			wantArgs: []any{"steel", "carbon"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := Sanitize(tt.where, cfg)
+			if err != nil {
+				t.Fatalf("Sanitize() error = %v", err)
+			}
+			if sql != tt.wantSQL {
+				t.Errorf("Sanitize() sql = %q, want %q", sql, tt.wantSQL)
+			}
+			if len(args) != len(tt.wantArgs) {
+				t.Fatalf("Sanitize() args = %#v, want %#v", args, tt.wantArgs)
+			}
+			for i := range args {
+				if args[i] != tt.wantArgs[i] {
+					t.Errorf("Sanitize() args[%d] = %#v, want %#v", i, args[i], tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+// TestSanitizeCaseInsensitiveLike confirms Config.CaseInsensitiveLike reaches Postgres's ILIKE
+// through Sanitize - SqlparserParser has no where-clause syntax that could set
+// Like.CaseInsensitive itself, so the config flag is the only way a caller gets there.
+func TestSanitizeCaseInsensitiveLike(t *testing.T) {
+	cfg := Config{
+		AllowLike:           true,
+		CaseInsensitiveLike: true,
+		Dialect:             PostgresDialect{},
+		Columns: map[string]ColumnSpec{
+			"material": {Type: ColumnTypeString, Ops: []Operator{OpLike}},
+		},
+	}
+
+	sql, args, err := Sanitize("material like 'steel%'", cfg)
+	if err != nil {
+		t.Fatalf("Sanitize() error = %v", err)
+	}
+	wantSQL := `"material" ILIKE $1`
+	if sql != wantSQL {
+		t.Errorf("Sanitize() sql = %q, want %q", sql, wantSQL)
+	}
+	wantArgs := []any{"steel%"}
+	if len(args) != len(wantArgs) || args[0] != wantArgs[0] {
+		t.Errorf("Sanitize() args = %#v, want %#v", args, wantArgs)
+	}
+}